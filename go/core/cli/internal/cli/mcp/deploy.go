@@ -51,6 +51,12 @@ func PackageDeployMcp(cfg *DeployCfg) error {
 		return fmt.Errorf("unsupported package manager: %s (must be 'npx' or 'uvx')", cfg.PackageManager)
 	}
 
+	transport, err := normalizeTransport(cfg.Transport)
+	if err != nil {
+		return err
+	}
+	cfg.Transport = transport
+
 	// Validate args
 	if len(cfg.Args) == 0 {
 		return fmt.Errorf("args are required (e.g., --args package-name)")
@@ -143,6 +149,19 @@ func PackageDeployMcp(cfg *DeployCfg) error {
 	return nil
 }
 
+// normalizeTransport lowercases a transport flag value so that values such as "HTTP" or
+// "Stdio" are accepted, and rejects unknown transports instead of silently falling
+// back to stdio.
+func normalizeTransport(value string) (string, error) {
+	transport := strings.ToLower(strings.TrimSpace(value))
+	switch transport {
+	case "", transportHTTP, transportStdio:
+		return transport, nil
+	default:
+		return "", fmt.Errorf("unsupported transport %q: must be one of %q or %q", value, transportStdio, transportHTTP)
+	}
+}
+
 // getTransportType determines the transport type based on flags
 func getTransportType(cfg *DeployCfg) v1alpha1.TransportType {
 	if cfg.Transport != "" {
@@ -170,6 +189,10 @@ func DeployMcp(cfg *DeployCfg) error {
 		return fmt.Errorf("failed to get config: %w", err)
 	}
 
+	if cfg.Transport, err = normalizeTransport(cfg.Transport); err != nil {
+		return err
+	}
+
 	if cfg.File != "" {
 		// Use specified file path
 		projectDir, err = getProjectDirFromFile(cfg.File)
@@ -0,0 +1,56 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kagent-dev/kmcp/api/v1alpha1"
+)
+
+func TestNormalizeTransport(t *testing.T) {
+	tests := []struct {
+		name          string
+		transport     string
+		wantTransport string
+		wantType      v1alpha1.TransportType
+		wantErr       bool
+	}{
+		{name: "empty defaults to stdio", transport: "", wantTransport: "", wantType: v1alpha1.TransportTypeStdio},
+		{name: "lowercase http", transport: "http", wantTransport: transportHTTP, wantType: v1alpha1.TransportTypeHTTP},
+		{name: "uppercase http", transport: "HTTP", wantTransport: transportHTTP, wantType: v1alpha1.TransportTypeHTTP},
+		{name: "mixed case stdio", transport: "Stdio", wantTransport: transportStdio, wantType: v1alpha1.TransportTypeStdio},
+		{name: "surrounding whitespace", transport: " Http ", wantTransport: transportHTTP, wantType: v1alpha1.TransportTypeHTTP},
+		{name: "unknown transport", transport: "sse", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, err := normalizeTransport(tt.transport)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "must be one of")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTransport, transport)
+			assert.Equal(t, tt.wantType, getTransportType(&DeployCfg{Transport: transport}))
+		})
+	}
+}
+
+func TestPackageDeployMcp_RejectsUnknownTransport(t *testing.T) {
+	cfg := &DeployCfg{
+		PackageManager: "npx",
+		PackageName:    "test-server",
+		Args:           []string{"@modelcontextprotocol/server-everything"},
+		Transport:      "websocket",
+		DryRun:         true,
+	}
+
+	err := PackageDeployMcp(cfg)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported transport")
+}
@@ -18,6 +18,12 @@ type RunCfg struct {
 }
 
 func RunMcp(cfg *RunCfg) error {
+	transport, err := normalizeTransport(cfg.Transport)
+	if err != nil {
+		return err
+	}
+	cfg.Transport = transport
+
 	projectDir, err := getProjectDir(cfg)
 	if err != nil {
 		return err
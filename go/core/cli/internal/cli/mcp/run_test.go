@@ -170,6 +170,34 @@ func TestRunMcp_MissingManifest(t *testing.T) {
 	assert.Contains(t, err.Error(), "manifest.yaml not found")
 }
 
+func TestRunMcp_Transport(t *testing.T) {
+	tests := []struct {
+		name          string
+		transport     string
+		wantTransport string
+		wantErr       string
+	}{
+		{name: "uppercase http", transport: "HTTP", wantTransport: transportHTTP, wantErr: "manifest.yaml not found"},
+		{name: "mixed case stdio", transport: "Stdio", wantTransport: transportStdio, wantErr: "manifest.yaml not found"},
+		{name: "unknown transport", transport: "websocket", wantTransport: "websocket", wantErr: "unsupported transport"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &RunCfg{
+				ProjectDir: t.TempDir(),
+				Transport:  tt.transport,
+			}
+
+			err := RunMcp(cfg)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Equal(t, tt.wantTransport, cfg.Transport)
+		})
+	}
+}
+
 func TestRunMcp_UnsupportedFramework(t *testing.T) {
 	tmpDir := t.TempDir()
 
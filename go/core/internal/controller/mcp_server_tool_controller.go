@@ -19,6 +19,9 @@ package controller
 import (
 	"context"
 	"errors"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/kagent-dev/kagent/go/core/internal/controller/predicates"
@@ -41,6 +44,24 @@ var mcpServerGK = schema.GroupKind{Group: "kagent.dev", Kind: "MCPServer"}
 type MCPServerToolController struct {
 	Scheme     *runtime.Scheme
 	Reconciler reconciler.KagentReconciler
+	// ResyncInterval is how often a successfully reconciled MCPServer is requeued so
+	// that its discovered tools are refreshed even if a watch event is missed.
+	// Zero disables the periodic requeue.
+	ResyncInterval time.Duration
+	// StartupJitter is the window after the controller starts over which the
+	// initial reconciles of the existing MCPServers are spread, so a restart does
+	// not hit the API server and every MCP endpoint at once. MCPServers reconciled
+	// after the window are not delayed. Zero disables the jitter.
+	StartupJitter time.Duration
+
+	// startedAt is when the controller started, in Unix nanoseconds, or zero if
+	// it has not been recorded yet. It is set by a leader-elected runnable, so it
+	// is the time this replica became leader rather than the time of the first
+	// reconcile.
+	startedAt atomic.Int64
+	// delayed records the MCPServers whose initial reconcile has been deferred
+	// during the startup window. It is cleared once the window has passed.
+	delayed sync.Map
 }
 
 // +kubebuilder:rbac:groups=kagent.dev,resources=mcpservers,verbs=get;list;watch
@@ -48,6 +69,10 @@ type MCPServerToolController struct {
 func (r *MCPServerToolController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	_ = log.FromContext(ctx)
 
+	if delay := r.startupDelay(req); delay > 0 {
+		return ctrl.Result{RequeueAfter: delay}, nil
+	}
+
 	err := r.Reconciler.ReconcileKagentMCPServer(ctx, req)
 	if err != nil {
 		// Check if this is a validation error that requires user action
@@ -60,18 +85,69 @@ func (r *MCPServerToolController) Reconcile(ctx context.Context, req ctrl.Reques
 		// Transient error - return error to trigger exponential backoff retry
 		return ctrl.Result{}, err
 	}
-	// Success - requeue after 60s to refresh tool server status
+	// Success - requeue after the resync interval to refresh tool server status
 	return ctrl.Result{
-		RequeueAfter: 60 * time.Second,
+		RequeueAfter: r.ResyncInterval,
 	}, nil
 }
 
+// startupDelay returns how long the first reconcile of req should be deferred.
+// Each MCPServer is assigned a slot within the startup window derived from its
+// key, so the slots are stable for a given MCPServer but spread across the
+// window for a set of them. Reconciles whose slot has already passed, and any
+// reconcile after the window, are not delayed.
+func (r *MCPServerToolController) startupDelay(req ctrl.Request) time.Duration {
+	if r.StartupJitter <= 0 {
+		return 0
+	}
+	startedAt := r.startedAt.Load()
+	if startedAt == 0 {
+		return 0
+	}
+	elapsed := time.Since(time.Unix(0, startedAt))
+	if elapsed >= r.StartupJitter {
+		r.delayed.Clear()
+		return 0
+	}
+	if _, seen := r.delayed.LoadOrStore(req.NamespacedName, struct{}{}); seen {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(req.String()))
+	slot := time.Duration(h.Sum64() % uint64(r.StartupJitter))
+	return max(slot-elapsed, 0)
+}
+
+// markStarted records the time the controller started, which opens the startup
+// jitter window.
+func (r *MCPServerToolController) markStarted(t time.Time) {
+	r.startedAt.Store(t.UnixNano())
+}
+
+// startupRecorder records when the MCPServer controller starts. It needs leader
+// election so that it runs together with the controller on the leader.
+type startupRecorder struct {
+	controller *MCPServerToolController
+}
+
+func (s startupRecorder) Start(context.Context) error {
+	s.controller.markStarted(time.Now())
+	return nil
+}
+
+func (s startupRecorder) NeedLeaderElection() bool { return true }
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *MCPServerToolController) SetupWithManager(mgr ctrl.Manager) error {
 	if _, err := mgr.GetRESTMapper().RESTMapping(mcpServerGK); err != nil {
 		ctrl.Log.Info("MCPServer CRD not found - controller will not be started", "controller", "mcpserver")
 		return nil
 	}
+	if r.StartupJitter > 0 {
+		if err := mgr.Add(startupRecorder{controller: r}); err != nil {
+			return err
+		}
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(controller.Options{
 			NeedLeaderElection: new(true),
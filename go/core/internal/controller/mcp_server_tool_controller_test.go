@@ -3,7 +3,9 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -95,7 +97,8 @@ func TestMCPServerToolController_ErrorTypeDetection(t *testing.T) {
 			}
 
 			controller := &MCPServerToolController{
-				Reconciler: fakeReconciler,
+				Reconciler:     fakeReconciler,
+				ResyncInterval: 60 * time.Second,
 			}
 
 			req := ctrl.Request{
@@ -122,6 +125,157 @@ func TestMCPServerToolController_ErrorTypeDetection(t *testing.T) {
 	}
 }
 
+// TestMCPServerToolController_ResyncInterval tests that a successful reconcile is
+// requeued after the configured resync interval, and not at all when it is zero.
+func TestMCPServerToolController_ResyncInterval(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name           string
+		resyncInterval time.Duration
+		expectedResult ctrl.Result
+	}{
+		{
+			name:           "custom interval",
+			resyncInterval: 30 * time.Second,
+			expectedResult: ctrl.Result{RequeueAfter: 30 * time.Second},
+		},
+		{
+			name:           "disabled",
+			resyncInterval: 0,
+			expectedResult: ctrl.Result{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := &MCPServerToolController{
+				Reconciler:     &fakeReconciler{},
+				ResyncInterval: tc.resyncInterval,
+			}
+
+			result, err := controller.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Namespace: "test", Name: "test-server"},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
+
+// TestMCPServerToolController_StartupJitter tests that the first reconcile of each
+// MCPServer is deferred by a delay spread across the jitter window, that the
+// deferred reconcile then runs normally, and that MCPServers first reconciled
+// after the window are not delayed.
+func TestMCPServerToolController_StartupJitter(t *testing.T) {
+	ctx := context.Background()
+	jitter := 30 * time.Second
+
+	controller := &MCPServerToolController{
+		Reconciler:     &fakeReconciler{},
+		ResyncInterval: 60 * time.Second,
+		StartupJitter:  jitter,
+	}
+	controller.markStarted(time.Now())
+
+	const servers = 100
+	const buckets = 5
+	counts := make([]int, buckets)
+	for i := range servers {
+		req := ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: "test", Name: fmt.Sprintf("server-%d", i)},
+		}
+
+		result, err := controller.Reconcile(ctx, req)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, result.RequeueAfter, time.Duration(0))
+		require.Less(t, result.RequeueAfter, jitter)
+		counts[int(result.RequeueAfter*buckets/jitter)]++
+
+		// The deferred reconcile is not delayed again.
+		result, err = controller.Reconcile(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, ctrl.Result{RequeueAfter: 60 * time.Second}, result)
+	}
+
+	for i, count := range counts {
+		assert.NotZero(t, count, "no reconcile started in jitter bucket %d", i)
+		assert.Less(t, count, servers/2, "reconciles bunched in jitter bucket %d", i)
+	}
+
+	// Move the start back so that the startup window has passed
+	controller.markStarted(time.Now().Add(-jitter))
+
+	result, err := controller.Reconcile(ctx, ctrl.Request{
+		NamespacedName: types.NamespacedName{Namespace: "test", Name: "created-later"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{RequeueAfter: 60 * time.Second}, result)
+
+	delayed := 0
+	controller.delayed.Range(func(_, _ any) bool {
+		delayed++
+		return true
+	})
+	assert.Zero(t, delayed, "delayed MCPServers should be forgotten after the startup window")
+}
+
+// TestMCPServerToolController_StartupJitterOutsideWindow tests that a reconcile is
+// not delayed when the controller start has not been recorded, or when it arrives
+// after the startup window without any earlier reconciles.
+func TestMCPServerToolController_StartupJitterOutsideWindow(t *testing.T) {
+	ctx := context.Background()
+	jitter := 30 * time.Second
+	req := ctrl.Request{
+		NamespacedName: types.NamespacedName{Namespace: "test", Name: "test-server"},
+	}
+
+	testCases := []struct {
+		name      string
+		startedAt time.Time
+	}{
+		{
+			name: "start not recorded",
+		},
+		{
+			name:      "window passed",
+			startedAt: time.Now().Add(-2 * jitter),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := &MCPServerToolController{
+				Reconciler:     &fakeReconciler{},
+				ResyncInterval: 60 * time.Second,
+				StartupJitter:  jitter,
+			}
+			if !tc.startedAt.IsZero() {
+				controller.markStarted(tc.startedAt)
+			}
+
+			result, err := controller.Reconcile(ctx, req)
+
+			require.NoError(t, err)
+			assert.Equal(t, ctrl.Result{RequeueAfter: 60 * time.Second}, result)
+		})
+	}
+}
+
+// TestStartupRecorder tests that the startup recorder runs on the leader and
+// records the controller start time.
+func TestStartupRecorder(t *testing.T) {
+	controller := &MCPServerToolController{}
+	recorder := startupRecorder{controller: controller}
+
+	assert.True(t, recorder.NeedLeaderElection())
+
+	before := time.Now()
+	require.NoError(t, recorder.Start(context.Background()))
+	assert.False(t, time.Unix(0, controller.startedAt.Load()).Before(before))
+}
+
 // TestMCPServerToolController_ErrorWrapping tests that the controller correctly
 // detects ValidationError even when wrapped with fmt.Errorf using %w.
 func TestMCPServerToolController_ErrorWrapping(t *testing.T) {
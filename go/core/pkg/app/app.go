@@ -116,6 +116,10 @@ type Config struct {
 	Proxy struct {
 		URL string
 	}
+	MCPServer struct {
+		ResyncInterval time.Duration
+		StartupJitter  time.Duration
+	}
 	LeaderElection     bool
 	ProbeAddr          string
 	SecureMetrics      bool
@@ -161,6 +165,9 @@ func (cfg *Config) SetFlags(commandLine *flag.FlagSet) {
 
 	commandLine.StringVar(&cfg.WatchNamespaces, "watch-namespaces", "", "The namespaces to watch for .")
 
+	commandLine.DurationVar(&cfg.MCPServer.ResyncInterval, "mcpserver-resync-interval", 60*time.Second, "How often MCPServers are periodically requeued to refresh their discovered tools. Set to 0 to disable periodic requeue.")
+	commandLine.DurationVar(&cfg.MCPServer.StartupJitter, "mcpserver-startup-jitter", 0, "Window after the controller starts over which the initial reconciles of existing MCPServers are spread. Set to 0 to reconcile immediately.")
+
 	commandLine.Var(&cfg.Streaming.MaxBufSize, "streaming-max-buf-size", "The maximum size of the streaming buffer.")
 	commandLine.Var(&cfg.Streaming.InitialBufSize, "streaming-initial-buf-size", "The initial size of the streaming buffer.")
 	commandLine.DurationVar(&cfg.Streaming.Timeout, "streaming-timeout", 600*time.Second, "The timeout for the streaming connection.")
@@ -466,8 +473,10 @@ func Start(getExtensionConfig GetExtensionConfig) {
 	}
 
 	if err := (&controller.MCPServerToolController{
-		Scheme:     mgr.GetScheme(),
-		Reconciler: rcnclr,
+		Scheme:         mgr.GetScheme(),
+		Reconciler:     rcnclr,
+		ResyncInterval: cfg.MCPServer.ResyncInterval,
+		StartupJitter:  cfg.MCPServer.StartupJitter,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Service")
 		os.Exit(1)
@@ -265,6 +265,36 @@ func TestDatabaseUrlFileFlag(t *testing.T) {
 	assert.Equal(t, "/etc/credentials/db-url", cfg.Database.UrlFile)
 }
 
+func TestMCPServerResyncIntervalFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	cfg := Config{}
+	cfg.SetFlags(fs)
+
+	f := fs.Lookup("mcpserver-resync-interval")
+	assert.NotNil(t, f, "mcpserver-resync-interval flag should be registered")
+	assert.Equal(t, 60*time.Second, cfg.MCPServer.ResyncInterval)
+
+	t.Setenv("MCPSERVER_RESYNC_INTERVAL", "0s")
+	err := LoadFromEnv(fs)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), cfg.MCPServer.ResyncInterval)
+}
+
+func TestMCPServerStartupJitterFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	cfg := Config{}
+	cfg.SetFlags(fs)
+
+	f := fs.Lookup("mcpserver-startup-jitter")
+	assert.NotNil(t, f, "mcpserver-startup-jitter flag should be registered")
+	assert.Equal(t, time.Duration(0), cfg.MCPServer.StartupJitter)
+
+	t.Setenv("MCPSERVER_STARTUP_JITTER", "30s")
+	err := LoadFromEnv(fs)
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, cfg.MCPServer.StartupJitter)
+}
+
 func TestMapValue(t *testing.T) {
 	tests := []struct {
 		name    string